@@ -0,0 +1,122 @@
+package jitter
+
+// Jitter computes a jittered delay from a raw duration and bounds,
+// mirroring the function-typed "Jitter" step used by go-libp2p-discovery.
+// Implementations should clamp their result to [min, max].
+type Jitter func(duration, min, max int64, r RandomFunc) int64
+
+// clampJitter bounds duration to [min, max].
+func clampJitter(duration, min, max int64) int64 {
+	if duration < min {
+		duration = min
+	}
+	if duration > max {
+		duration = max
+	}
+	return duration
+}
+
+// FullJitterFn is the "full jitter" algorithm as a bare Jitter func:
+// clamp duration to [min, max], then return U[min, clamped].
+func FullJitterFn(duration, min, max int64, r RandomFunc) int64 {
+	d := clampJitter(duration, min, max)
+
+	span := d - min
+	if span <= 0 {
+		return min
+	}
+
+	return min + r(span)
+}
+
+// EqualJitterFn is the "equal jitter" algorithm as a bare Jitter func:
+// clamp duration to [min, max], then return mid + U[0, d-mid] where mid
+// is the midpoint between min and the clamped duration d.
+func EqualJitterFn(duration, min, max int64, r RandomFunc) int64 {
+	d := clampJitter(duration, min, max)
+
+	span := d - min
+	if span <= 0 {
+		return min
+	}
+
+	half := span / 2
+	rest := span - half
+	if rest <= 0 {
+		return min + half
+	}
+
+	return min + half + r(rest)
+}
+
+// NoJitterFn clamps duration to [min, max] and returns it unchanged; r is
+// ignored. Useful as a drop-in no-op when composing with NewFromJitter.
+func NoJitterFn(duration, min, max int64, r RandomFunc) int64 {
+	return clampJitter(duration, min, max)
+}
+
+// DecorrelatedJitterFn returns a Jitter closure implementing decorrelated
+// jitter: each call draws from U[min, prev*multiplier], clamped to max,
+// where prev starts at base and is updated to the drawn value after every
+// call. multiplier <= 0 defaults to 3, matching decorrelatedJitter's
+// default growth factor.
+//
+// The returned Jitter is stateful and not safe for concurrent use from
+// multiple goroutines.
+func DecorrelatedJitterFn(base int64, multiplier float64) Jitter {
+	if multiplier <= 0 {
+		multiplier = 3
+	}
+
+	prev := base
+
+	return func(duration, min, max int64, r RandomFunc) int64 {
+		hi := int64(float64(prev) * multiplier)
+		hi = clampJitter(hi, min, max)
+
+		span := hi - min
+		next := min
+		if span > 0 {
+			next = min + r(span)
+		}
+
+		prev = next
+		return next
+	}
+}
+
+// NewFromJitter adapts a bare Jitter func into a Strategy, growing the raw
+// duration exponentially (the same base*2^attempt curve used elsewhere in
+// this package) and clamping it to [0, cfg.Cap] before handing it to fn.
+// Returns an error if fn is nil, cfg.Base <= 0, cfg.Cap <= 0, or
+// cfg.Random is nil.
+func NewFromJitter(fn Jitter, cfg Config) (Strategy, error) {
+	issues := getJitterConfigIssues(cfg)
+	if fn == nil {
+		issues = append(issues, "Jitter function must be provided")
+	}
+	if len(issues) > 0 {
+		return nil, &ConfigError{Issues: issues}
+	}
+
+	return &jitterFnStrategy{
+		base:   cfg.Base,
+		cap:    cfg.Cap,
+		random: cfg.Random,
+		fn:     fn,
+	}, nil
+}
+
+// jitterFnStrategy adapts a Jitter func into a Strategy by feeding it an
+// exponentially growing duration clamped to [0, cap].
+type jitterFnStrategy struct {
+	base   int64
+	cap    int64
+	random RandomFunc
+	fn     Jitter
+}
+
+func (j *jitterFnStrategy) Next(attempt int) int64 {
+	duration := expCap(j.base, j.cap, attempt)
+	return j.fn(duration, 0, j.cap, j.random)
+}
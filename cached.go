@@ -0,0 +1,62 @@
+package jitter
+
+import (
+	"sync"
+	"time"
+)
+
+// cached wraps a Strategy so that repeated calls to Next within ttl of the
+// last computed delay return the cached value without advancing inner's
+// state; once ttl has elapsed, Next delegates to inner and remembers the
+// new delay and the time it was produced.
+type cached struct {
+	inner Strategy
+	ttl   time.Duration
+	clock func() time.Time
+
+	mu       sync.Mutex
+	delay    int64
+	computed time.Time
+}
+
+// Cached returns a Strategy decorator that suppresses recomputation of
+// inner's delay for ttl after it is last produced. This mirrors the
+// "backoff cache" pattern from go-libp2p-discovery, where repeated Delay()
+// calls in a short window shouldn't keep escalating backoff. clock
+// supplies the current time (pass time.Now in production; tests can
+// substitute a fake clock); a nil clock defaults to time.Now.
+//
+// The returned Strategy is safe for concurrent use.
+func Cached(inner Strategy, ttl time.Duration, clock func() time.Time) Strategy {
+	if clock == nil {
+		clock = time.Now
+	}
+	return &cached{inner: inner, ttl: ttl, clock: clock}
+}
+
+func (c *cached) Next(attempt int) int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.clock()
+	if !c.computed.IsZero() && now.Sub(c.computed) < c.ttl {
+		return c.delay
+	}
+
+	c.delay = c.inner.Next(attempt)
+	c.computed = now
+	return c.delay
+}
+
+// Peek reports the delay that the next call to Next will return if it
+// hits the cache, and how long ago it was computed. Before the first call
+// to Next, it returns (0, 0).
+func (c *cached) Peek() (delay int64, age time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.computed.IsZero() {
+		return 0, 0
+	}
+	return c.delay, c.clock().Sub(c.computed)
+}
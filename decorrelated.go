@@ -1,50 +1,68 @@
 package jitter
 
-// decorrelatedJitter implements backoff = min(cap, U[base, prev * 3]), return backoff,
-// where prev is the previous sleep value.
+// decorrelatedJitter implements backoff = min(cap, U[base, prev * Multiplier]),
+// return backoff, where prev is the previous sleep value.
 type decorrelatedJitter struct {
-	base   int64
-	cap    int64
-	random RandomFunc
+	base       int64
+	cap        int64
+	multiplier float64
+	random     RandomFunc
 
 	sleep int64 // last computed delay
 }
 
 // NewDecorrelated returns a Strategy using the "decorrelated jitter" algorithm.
+// cfg.Multiplier <= 0 defaults to 3, matching the original prev*3 growth.
 // Returns an error if cfg.Base <= 0, cfg.Cap <= 0, or cfg.Random is nil.
 //
 // The returned Strategy is stateful and not safe for concurrent use from
 // multiple goroutines. Callers should create a new Strategy for each
-// independent retry loop.
+// independent retry loop; it also implements Resettable and can be
+// rewound to its initial state with Reset.
 func NewDecorrelated(cfg Config) (Strategy, error) {
 	if issues := getJitterConfigIssues(cfg); len(issues) > 0 {
 		return nil, &ConfigError{Issues: issues}
 	}
 
+	multiplier := cfg.Multiplier
+	if multiplier <= 0 {
+		multiplier = 3
+	}
+
 	return &decorrelatedJitter{
-		base:   cfg.Base,
-		cap:    cfg.Cap,
-		random: cfg.Random,
-		sleep:  cfg.Base,
+		base:       cfg.Base,
+		cap:        cfg.Cap,
+		multiplier: multiplier,
+		random:     cfg.Random,
+		sleep:      cfg.Base,
 	}, nil
 }
 
-// The attempt parameter is used only as a reset signal:
-// - attempt < 1: reset internal state to base and start a new sequence
-// - attempt  >= 1: continue from the previous sleep value
-func (d *decorrelatedJitter) Next(attempt int) int64 {
-	// reset on first attempt
-	if attempt < 1 {
-		d.sleep = d.base
-	}
+// Reset rewinds the strategy back to its initial state, so the next call
+// to Next starts a fresh sequence from base. This is the only way to
+// reset a decorrelatedJitter; attempt is otherwise ignored by Next, so
+// callers resuming a sequence (eg from a non-zero starting attempt) won't
+// trigger an unwanted reset.
+func (d *decorrelatedJitter) Reset() {
+	d.sleep = d.base
+}
 
+// Peek returns the last delay produced by Next, or base if Next has not
+// been called yet.
+func (d *decorrelatedJitter) Peek() int64 {
+	return d.sleep
+}
+
+// Next ignores attempt; decorrelated jitter only grows from its own
+// previous sleep value. Call Reset to start a new sequence.
+func (d *decorrelatedJitter) Next(attempt int) int64 {
 	// safeguard, ensure we have a valid previous sleep value
 	if d.sleep <= 0 {
 		d.sleep = d.base
 	}
 
 	min := d.base
-	max := d.sleep * 3
+	max := int64(float64(d.sleep) * d.multiplier)
 
 	if max < min {
 		max = min
@@ -64,7 +82,7 @@ func (d *decorrelatedJitter) Next(attempt int) int64 {
 		return d.sleep
 	}
 
-	// U[base, prev*3] clamped to cap == base + U[0, span]
+	// U[base, prev*multiplier] clamped to cap == base + U[0, span]
 	next := min + d.random(span)
 	if next <= 0 {
 		next = min
@@ -1,9 +1,8 @@
 package jitter
 
-// equalJitter implements backoff = min(cap, base * 2^attempt), return backoff/2 + U[0, backoff/2].
+// equalJitter implements backoff = min(cap, growth(attempt)), return backoff/2 + U[0, backoff/2].
 type equalJitter struct {
-	base   int64
-	cap    int64
+	growth growth
 	random RandomFunc
 }
 
@@ -15,14 +14,13 @@ func NewEqual(cfg Config) (Strategy, error) {
 	}
 
 	return &equalJitter{
-		base:   cfg.Base,
-		cap:    cfg.Cap,
+		growth: expGrowth{base: cfg.Base, cap: cfg.Cap},
 		random: cfg.Random,
 	}, nil
 }
 
 func (e *equalJitter) Next(attempt int) int64 {
-	backoff := expCap(e.base, e.cap, attempt)
+	backoff := e.growth.At(attempt)
 	if backoff <= 0 {
 		return 0
 	}
@@ -0,0 +1,47 @@
+package jitter
+
+// fibGrowth implements an iterative Fibonacci recurrence, clamped to cap:
+// f(0) = base, f(1) = base, f(n) = f(n-1) + f(n-2).
+type fibGrowth struct {
+	base int64
+	cap  int64
+}
+
+func (g fibGrowth) At(attempt int) int64 {
+	if attempt < 0 || g.base <= 0 || g.cap <= 0 {
+		return 0
+	}
+
+	prev, cur := g.base, g.base
+	for i := 1; i < attempt; i++ {
+		// detect overflow/overshoot before adding, since prev+cur can wrap
+		// past math.MaxInt64 once cur approaches cap
+		if prev > g.cap-cur {
+			cur = g.cap
+			break
+		}
+		prev, cur = cur, prev+cur
+	}
+
+	if cur > g.cap {
+		return g.cap
+	}
+	return cur
+}
+
+// NewFibonacci returns a Strategy using Fibonacci backoff with full
+// jitter: backoff = min(cap, fib(attempt)) where the sequence starts from
+// base, return U[0, backoff]. Like NewPolynomial, this ramps slower than
+// exponential backoff.
+//
+// Returns an error if cfg.Base <= 0, cfg.Cap <= 0, or cfg.Random is nil.
+func NewFibonacci(cfg Config) (Strategy, error) {
+	if issues := getJitterConfigIssues(cfg); len(issues) > 0 {
+		return nil, &ConfigError{Issues: issues}
+	}
+
+	return &fullJitter{
+		growth: fibGrowth{base: cfg.Base, cap: cfg.Cap},
+		random: cfg.Random,
+	}, nil
+}
@@ -0,0 +1,34 @@
+package jitter
+
+import "testing"
+
+func TestFibonacciNextZeroAttempt(t *testing.T) {
+	s, err := NewFibonacci(Config{
+		Base:   100,
+		Cap:    1000,
+		Random: DefaultRandom,
+	})
+	if err != nil {
+		t.Fatalf("NewFibonacci: %v", err)
+	}
+
+	// fib(0) == base, so Next(0) must land in [0, base] rather than
+	// panicking the way it would if fullJitter passed 0 to Random.
+	if got := s.Next(0); got < 0 || got > 100 {
+		t.Errorf("Next(0) = %d, want in [0, 100]", got)
+	}
+}
+
+func TestFibonacciGrowthClampsWithoutOverflow(t *testing.T) {
+	g := fibGrowth{base: 1, cap: 1<<62 - 1}
+
+	for attempt := 0; attempt <= 200; attempt++ {
+		got := g.At(attempt)
+		if got < 0 {
+			t.Fatalf("At(%d) = %d, want non-negative (overflowed)", attempt, got)
+		}
+		if got > g.cap {
+			t.Fatalf("At(%d) = %d, want <= cap %d", attempt, got, g.cap)
+		}
+	}
+}
@@ -1,9 +1,8 @@
 package jitter
 
-// fullJitter implements backoff = min(cap, base * 2^attempt), return U[0, backoff].
+// fullJitter implements backoff = min(cap, growth(attempt)), return U[0, backoff].
 type fullJitter struct {
-	base   int64
-	cap    int64
+	growth growth
 	random RandomFunc
 }
 
@@ -15,17 +14,15 @@ func NewFull(cfg Config) (Strategy, error) {
 	}
 
 	return &fullJitter{
-		base:   cfg.Base,
-		cap:    cfg.Cap,
+		growth: expGrowth{base: cfg.Base, cap: cfg.Cap},
 		random: cfg.Random,
 	}, nil
 }
 
 func (f *fullJitter) Next(attempt int) int64 {
-	// base * 2^attempt, clamped to cap
-	max := expCap(f.base, f.cap, attempt)
+	max := f.growth.At(attempt)
 
-	if max < 0 {
+	if max <= 0 {
 		return 0
 	}
 
@@ -21,6 +21,36 @@ type Config struct {
 	Base   int64      // base delay, eg 100 (ms, but time unit is caller's responsibility)
 	Cap    int64      // maximum delay (should be the same unit as Base)
 	Random RandomFunc // random function to produce U[0, max)
+
+	// Multiplier is the growth factor used by NewDecorrelated (prev *
+	// Multiplier); ignored by other strategies. <= 0 defaults to 3.
+	Multiplier float64
+}
+
+// Resettable is implemented by strategies that carry internal state across
+// calls to Next and support resetting that state back to its initial
+// value, eg decorrelatedJitter. Callers that need to reset a Strategy
+// should type-assert to this interface.
+type Resettable interface {
+	Reset()
+}
+
+// growth computes a monotonic backoff ceiling for a given attempt, before
+// jitter is applied. fullJitter and equalJitter compose over any growth
+// implementation rather than assuming doubling, so strategies like
+// NewPolynomial and NewFibonacci can reuse the same jitter step.
+type growth interface {
+	At(attempt int) int64
+}
+
+// expGrowth implements base * 2^attempt, clamped to cap.
+type expGrowth struct {
+	base int64
+	cap  int64
+}
+
+func (g expGrowth) At(attempt int) int64 {
+	return expCap(g.base, g.cap, attempt)
 }
 
 // expCap computes base * 2^attempt, clamped to cap.
@@ -0,0 +1,76 @@
+package jitter
+
+import "math"
+
+// PolyConfig configures a polynomial growth strategy. Base, Cap, and
+// Exponent must be > 0.
+type PolyConfig struct {
+	Base     float64    // base delay, eg 100 (ms, but time unit is caller's responsibility)
+	Cap      float64    // maximum delay (should be the same unit as Base)
+	Exponent float64    // growth exponent, eg 2 for quadratic, 1 for linear
+	Random   RandomFunc // random function to produce U[0, max)
+}
+
+// polyGrowth implements backoff = min(cap, base * attempt^exponent).
+type polyGrowth struct {
+	base     float64
+	cap      float64
+	exponent float64
+}
+
+func (g polyGrowth) At(attempt int) int64 {
+	if attempt < 0 || g.base <= 0 || g.cap <= 0 {
+		return 0
+	}
+
+	val := g.base * math.Pow(float64(attempt), g.exponent)
+	if val > g.cap {
+		val = g.cap
+	}
+	if val < 0 {
+		val = 0
+	}
+	// clamp before the int64 conversion: a caller-supplied Cap above
+	// MaxInt64 would otherwise make this an out-of-range float->int
+	// conversion, which is implementation-defined and can go negative.
+	if val > float64(math.MaxInt64) {
+		val = float64(math.MaxInt64)
+	}
+
+	return int64(val)
+}
+
+func getPolyConfigIssues(cfg PolyConfig) []string {
+	var issues []string
+	if cfg.Base <= 0 {
+		issues = append(issues, "Base must be > 0")
+	}
+	if cfg.Cap <= 0 {
+		issues = append(issues, "Cap must be > 0")
+	}
+	if cfg.Exponent <= 0 {
+		issues = append(issues, "Exponent must be > 0")
+	}
+	if cfg.Random == nil {
+		issues = append(issues, "Random function must be provided")
+	}
+	return issues
+}
+
+// NewPolynomial returns a Strategy using polynomial backoff with full
+// jitter: backoff = min(cap, base * attempt^exponent), return U[0, backoff].
+// Useful for slow-ramp curves (eg health checks) where exponential growth
+// climbs too fast.
+//
+// Returns an error if cfg.Base <= 0, cfg.Cap <= 0, cfg.Exponent <= 0, or
+// cfg.Random is nil.
+func NewPolynomial(cfg PolyConfig) (Strategy, error) {
+	if issues := getPolyConfigIssues(cfg); len(issues) > 0 {
+		return nil, &ConfigError{Issues: issues}
+	}
+
+	return &fullJitter{
+		growth: polyGrowth{base: cfg.Base, cap: cfg.Cap, exponent: cfg.Exponent},
+		random: cfg.Random,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package jitter
+
+import "testing"
+
+func TestPolynomialNextZeroAttempt(t *testing.T) {
+	s, err := NewPolynomial(PolyConfig{
+		Base:     100,
+		Cap:      1000,
+		Exponent: 2,
+		Random:   DefaultRandom,
+	})
+	if err != nil {
+		t.Fatalf("NewPolynomial: %v", err)
+	}
+
+	// attempt 0 drives growth to base*0^exponent == 0; Next must short
+	// circuit rather than call Random(0), which panics for RandomFuncs
+	// backed by math/rand's Int63n.
+	if got := s.Next(0); got != 0 {
+		t.Errorf("Next(0) = %d, want 0", got)
+	}
+}
+
+func TestPolynomialNextWithinCap(t *testing.T) {
+	s, err := NewPolynomial(PolyConfig{
+		Base:     10,
+		Cap:      1000,
+		Exponent: 2,
+		Random:   DefaultRandom,
+	})
+	if err != nil {
+		t.Fatalf("NewPolynomial: %v", err)
+	}
+
+	for attempt := 0; attempt <= 6; attempt++ {
+		got := s.Next(attempt)
+		if got < 0 || got > 1000 {
+			t.Errorf("Next(%d) = %d, want in [0, 1000]", attempt, got)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package jitter
+
+import (
+	"math/rand"
+	randv2 "math/rand/v2"
+	"sync"
+	"time"
+)
+
+// NewLockedRandom returns a RandomFunc backed by a math/rand source seeded
+// with seed, guarded by a sync.Mutex so it is safe to share across
+// goroutines. This is the locked-source pattern needed whenever a single
+// stateful Strategy (eg NewDecorrelated) is shared by concurrent callers.
+func NewLockedRandom(seed int64) RandomFunc {
+	src := rand.New(rand.NewSource(seed))
+	var mu sync.Mutex
+
+	return func(max int64) int64 {
+		mu.Lock()
+		defer mu.Unlock()
+		return src.Int63n(max)
+	}
+}
+
+// DefaultRandom is a package-level RandomFunc seeded from the current
+// time, for callers that don't need a custom or reproducible RNG.
+var DefaultRandom RandomFunc = NewLockedRandom(time.Now().UnixNano())
+
+// NewRandomFromRand adapts an existing *rand.Rand into a RandomFunc. The
+// caller is responsible for synchronizing access if r is shared across
+// goroutines.
+func NewRandomFromRand(r *rand.Rand) RandomFunc {
+	return func(max int64) int64 {
+		return r.Int63n(max)
+	}
+}
+
+// NewRandomFromV2 adapts a *rand/v2.Rand into a RandomFunc. The caller is
+// responsible for synchronizing access if r is shared across goroutines.
+func NewRandomFromV2(r *randv2.Rand) RandomFunc {
+	return func(max int64) int64 {
+		return r.Int64N(max)
+	}
+}
@@ -0,0 +1,107 @@
+package jitter
+
+import (
+	"context"
+	"time"
+)
+
+// RetryerConfig configures a Retryer.
+type RetryerConfig struct {
+	Strategy Strategy // required; produces the raw delay for each attempt
+
+	MaxAttempts int           // maximum number of calls to fn, including the first; 0 means unlimited
+	Deadline    time.Duration // overall deadline across all attempts; 0 means no overall deadline
+	Unit        time.Duration // scales Strategy.Next's return value into a time.Duration; 0 defaults to time.Millisecond
+
+	// Retryable decides whether err should trigger another attempt.
+	// nil means every non-nil error is retryable.
+	Retryable func(err error) bool
+
+	// OnRetry, if set, is called before sleeping ahead of each retry.
+	OnRetry func(attempt int, delay time.Duration, err error)
+	// OnGiveUp, if set, is called once Do has decided not to retry again.
+	OnGiveUp func(attempt int, err error)
+}
+
+func getRetryerConfigIssues(cfg RetryerConfig) []string {
+	var issues []string
+	if cfg.Strategy == nil {
+		issues = append(issues, "Strategy must be provided")
+	}
+	return issues
+}
+
+// Retryer turns a Strategy's arbitrary-unit delays into real sleeps and
+// drives a retry loop around an arbitrary function, honoring a context,
+// an attempt cap, and an overall deadline.
+type Retryer struct {
+	cfg RetryerConfig
+}
+
+// NewRetryer returns a Retryer driven by cfg.
+// Returns an error if cfg.Strategy is nil.
+func NewRetryer(cfg RetryerConfig) (*Retryer, error) {
+	if issues := getRetryerConfigIssues(cfg); len(issues) > 0 {
+		return nil, &ConfigError{Issues: issues}
+	}
+
+	if cfg.Unit <= 0 {
+		cfg.Unit = time.Millisecond
+	}
+
+	return &Retryer{cfg: cfg}, nil
+}
+
+// Do calls fn, retrying according to the Retryer's Strategy until fn
+// succeeds, the Retryable predicate rejects an error, MaxAttempts is
+// reached, the configured Deadline elapses, or ctx is done.
+//
+// It returns nil on success, the last error from fn if retries were
+// exhausted or rejected, or ctx.Err() if ctx (or the Deadline derived
+// from it) ended the loop while waiting to retry.
+func (r *Retryer) Do(ctx context.Context, fn func() error) error {
+	if r.cfg.Deadline > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.cfg.Deadline)
+		defer cancel()
+	}
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = fn()
+		if err == nil {
+			return nil
+		}
+
+		if r.cfg.Retryable != nil && !r.cfg.Retryable(err) {
+			if r.cfg.OnGiveUp != nil {
+				r.cfg.OnGiveUp(attempt, err)
+			}
+			return err
+		}
+
+		if r.cfg.MaxAttempts > 0 && attempt >= r.cfg.MaxAttempts {
+			if r.cfg.OnGiveUp != nil {
+				r.cfg.OnGiveUp(attempt, err)
+			}
+			return err
+		}
+
+		delay := time.Duration(r.cfg.Strategy.Next(attempt)) * r.cfg.Unit
+
+		if r.cfg.OnRetry != nil {
+			r.cfg.OnRetry(attempt, delay, err)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if r.cfg.OnGiveUp != nil {
+				r.cfg.OnGiveUp(attempt, err)
+			}
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}